@@ -0,0 +1,304 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const magicCommentPrefix = "enumnames:generate"
+
+// enumValue is a single constant belonging to a marked enum block.
+type enumValue struct {
+	ConstName string
+	Name      string
+	Value     int64
+}
+
+// enum describes one //enumnames:generate block found in the package.
+type enum struct {
+	packageName string
+	typeName    string
+	values      []enumValue
+}
+
+// findEnums parses every non-test Go file in dir, and returns one enum per
+// "//enumnames:generate <TypeName>" comment found above a const block.
+func findEnums(dir string) ([]enum, error) {
+	fset := token.NewFileSet()
+	packageName, files, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	typeNames, err := collectMarkedTypeNames(files)
+	if err != nil {
+		return nil, err
+	}
+	if len(typeNames) == 0 {
+		return nil, nil
+	}
+
+	values, err := collectConstValues(fset, packageName, files, typeNames)
+	if err != nil {
+		return nil, err
+	}
+
+	enums := make([]enum, 0, len(typeNames))
+	for _, typeName := range typeNames {
+		e := enum{packageName: packageName, typeName: typeName, values: values[typeName]}
+		if err := e.validate(); err != nil {
+			return nil, err
+		}
+		enums = append(enums, e)
+	}
+
+	if err := checkOutputFileCollisions(enums); err != nil {
+		return nil, err
+	}
+	return enums, nil
+}
+
+// checkOutputFileCollisions fails if two marked types would generate the same output file, e.g.
+// Color and COLOR both lower-case to color_enumnames.go. Without this, the second type generated
+// would silently overwrite the first type's file, on both case-sensitive and case-insensitive
+// filesystems (since the comparison here, like the filename itself, is case-insensitive).
+func checkOutputFileCollisions(enums []enum) error {
+	typeNameByFile := make(map[string]string, len(enums))
+	for _, e := range enums {
+		fileName := e.outputFileName()
+		if existing, ok := typeNameByFile[fileName]; ok {
+			return fmt.Errorf(
+				"types %s and %s would both generate %s: "+
+					"rename one of the types to avoid the collision",
+				existing, e.typeName, e.outputFileName(),
+			)
+		}
+		typeNameByFile[fileName] = e.typeName
+	}
+	return nil
+}
+
+func parseDir(fset *token.FileSet, dir string) (packageName string, files []*ast.File, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return "", nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+		if packageName == "" {
+			packageName = file.Name.Name
+		}
+		files = append(files, file)
+	}
+
+	if len(files) == 0 {
+		return "", nil, fmt.Errorf("no Go files found in %s", dir)
+	}
+	return packageName, files, nil
+}
+
+// collectMarkedTypeNames finds every const block with a doc comment containing
+// "//enumnames:generate <TypeName>", and returns the marked type names in source order.
+func collectMarkedTypeNames(files []*ast.File) ([]string, error) {
+	var typeNames []string
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST || genDecl.Doc == nil {
+				continue
+			}
+
+			for _, line := range genDecl.Doc.List {
+				text := strings.TrimSpace(strings.TrimPrefix(line.Text, "//"))
+				typeName, ok := strings.CutPrefix(text, magicCommentPrefix)
+				if !ok {
+					continue
+				}
+				typeName = strings.TrimSpace(typeName)
+				if typeName == "" {
+					return nil, fmt.Errorf(
+						"%s comment must be followed by a type name",
+						magicCommentPrefix,
+					)
+				}
+				typeNames = append(typeNames, typeName)
+			}
+		}
+	}
+
+	return typeNames, nil
+}
+
+// collectConstValues type-checks the package's files to resolve the int64 value of every
+// constant (including those using iota), and groups them by the enum type they belong to.
+func collectConstValues(
+	fset *token.FileSet,
+	packageName string,
+	files []*ast.File,
+	typeNames []string,
+) (map[string][]enumValue, error) {
+	wanted := make(map[string]bool, len(typeNames))
+	for _, typeName := range typeNames {
+		wanted[typeName] = true
+	}
+
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	config := types.Config{Importer: importer.Default(), Error: func(error) {}}
+	// Type errors from unrelated code in the package are not fatal here: we only need the Defs
+	// populated for the constants of the marked enum types, and those are always resolvable
+	// without needing the rest of the package to type-check cleanly.
+	_, _ = config.Check(packageName, fset, files, info)
+
+	values := make(map[string][]enumValue, len(typeNames))
+	for ident, obj := range info.Defs {
+		if ident.Name == "_" {
+			continue
+		}
+		constObj, ok := obj.(*types.Const)
+		if !ok {
+			continue
+		}
+
+		typeName := constObj.Type().String()
+		if idx := strings.LastIndexByte(typeName, '.'); idx >= 0 {
+			typeName = typeName[idx+1:]
+		}
+		if !wanted[typeName] {
+			continue
+		}
+
+		intValue, exact := constant.Int64Val(constant.ToInt(constObj.Val()))
+		if !exact {
+			return nil, fmt.Errorf(
+				"%s: constant %s does not fit in an int64", typeName, constObj.Name(),
+			)
+		}
+
+		values[typeName] = append(values[typeName], enumValue{
+			ConstName: constObj.Name(),
+			Name:      deriveName(typeName, ident.Name),
+			Value:     intValue,
+		})
+	}
+
+	return values, nil
+}
+
+// deriveName strips the enum type name prefix off a constant identifier, the way stringer-style
+// tools conventionally name constants (e.g. ColorRed -> "Red" for type Color). If the identifier
+// doesn't have that prefix, it is used as-is.
+func deriveName(typeName, constName string) string {
+	if rest, ok := strings.CutPrefix(constName, typeName); ok && rest != "" {
+		return rest
+	}
+	return constName
+}
+
+// validate checks the contiguity and duplicate-name constraints that enumnames.NewMap would
+// otherwise only catch at runtime, and sorts values by their integer value.
+func (e *enum) validate() error {
+	if len(e.values) == 0 {
+		return fmt.Errorf("no constants of type %s found", e.typeName)
+	}
+
+	sort.Slice(e.values, func(i, j int) bool { return e.values[i].Value < e.values[j].Value })
+
+	seenNames := make(map[string]bool, len(e.values))
+	for i, value := range e.values {
+		if i > 0 && value.Value != e.values[i-1].Value+1 {
+			return fmt.Errorf(
+				"constants of type %s are not contiguous: %s (%d) follows %s (%d)",
+				e.typeName, value.ConstName, value.Value,
+				e.values[i-1].ConstName, e.values[i-1].Value,
+			)
+		}
+		if seenNames[value.Name] {
+			return fmt.Errorf(
+				"duplicate enum name '%s' derived for type %s", value.Name, e.typeName,
+			)
+		}
+		seenNames[value.Name] = true
+	}
+
+	return nil
+}
+
+// mapVarName is the name of the generated package-level enumnames.Map variable, e.g. colorNames
+// for a Color type.
+func (e enum) mapVarName() string {
+	return strings.ToLower(e.typeName[:1]) + e.typeName[1:] + "Names"
+}
+
+// allFuncName is the name of the generated accessor for all of the enum's values, e.g.
+// AllColors for a Color type.
+func (e enum) allFuncName() string {
+	return "All" + strings.ToUpper(e.typeName[:1]) + e.typeName[1:] + "s"
+}
+
+// outputFileName is the name of the file generateFile writes the enum's generated code to, e.g.
+// color_enumnames.go for a Color type.
+func (e enum) outputFileName() string {
+	return strings.ToLower(e.typeName) + "_enumnames.go"
+}
+
+func generateFile(dir string, e enum) error {
+	var source strings.Builder
+
+	fmt.Fprintf(&source, "// Code generated by enumnamesgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&source, "package %s\n\n", e.packageName)
+	fmt.Fprintf(&source, "import \"hermannm.dev/enumnames\"\n\n")
+
+	mapVar := e.mapVarName()
+	fmt.Fprintf(&source, "var %s = enumnames.NewMap(map[%s]string{\n", mapVar, e.typeName)
+	for _, value := range e.values {
+		fmt.Fprintf(&source, "\t%s: %s,\n", value.ConstName, strconv.Quote(value.Name))
+	}
+	fmt.Fprintf(&source, "})\n\n")
+
+	fmt.Fprintf(&source, "func (value %s) String() string {\n", e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.GetNameOrFallback(value, \"UNKNOWN\")\n}\n\n", mapVar)
+
+	fmt.Fprintf(&source, "func (value %s) MarshalJSON() ([]byte, error) {\n", e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.MarshalToNameJSON(value)\n}\n\n", mapVar)
+
+	fmt.Fprintf(&source, "func (value *%s) UnmarshalJSON(bytes []byte) error {\n", e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.UnmarshalFromNameJSON(bytes, value)\n}\n\n", mapVar)
+
+	fmt.Fprintf(&source, "func (value %s) MarshalText() ([]byte, error) {\n", e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.MarshalToNameText(value)\n}\n\n", mapVar)
+
+	fmt.Fprintf(&source, "func (value *%s) UnmarshalText(text []byte) error {\n", e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.UnmarshalFromNameText(text, value)\n}\n\n", mapVar)
+
+	fmt.Fprintf(&source, "func %s() []%s {\n", e.allFuncName(), e.typeName)
+	fmt.Fprintf(&source, "\treturn %s.Keys()\n}\n", mapVar)
+
+	formatted, err := format.Source([]byte(source.String()))
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	outputPath := filepath.Join(dir, e.outputFileName())
+	return os.WriteFile(outputPath, formatted, 0o644)
+}
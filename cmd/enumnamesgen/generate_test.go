@@ -0,0 +1,162 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSourceFile(t *testing.T, dir, source string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, "color.go"), []byte(source), 0o644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+}
+
+const validColorSource = `package example
+
+//enumnames:generate Color
+const (
+	ColorRed Color = iota + 1
+	ColorGreen
+	ColorBlue
+)
+
+type Color uint8
+`
+
+func TestFindEnums(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceFile(t, dir, validColorSource)
+
+	enums, err := findEnums(dir)
+	if err != nil {
+		t.Fatalf("expected findEnums to succeed, but got error: %v", err)
+	}
+	if len(enums) != 1 {
+		t.Fatalf("expected 1 enum, got %d", len(enums))
+	}
+
+	enum := enums[0]
+	if enum.typeName != "Color" {
+		t.Fatalf("expected type name 'Color', got '%s'", enum.typeName)
+	}
+
+	expectedNames := []string{"Red", "Green", "Blue"}
+	if len(enum.values) != len(expectedNames) {
+		t.Fatalf("expected %d values, got %d", len(expectedNames), len(enum.values))
+	}
+	for i, value := range enum.values {
+		if value.Name != expectedNames[i] {
+			t.Fatalf("expected name '%s' at index %d, got '%s'", expectedNames[i], i, value.Name)
+		}
+		if value.Value != int64(i+1) {
+			t.Fatalf("expected value %d at index %d, got %d", i+1, i, value.Value)
+		}
+	}
+}
+
+func TestFindEnumsRejectsNonContiguousConstants(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceFile(t, dir, `package example
+
+//enumnames:generate Color
+const (
+	ColorRed Color = iota + 1
+	ColorGreen
+	_
+	ColorBlue
+)
+
+type Color uint8
+`)
+
+	if _, err := findEnums(dir); err == nil {
+		t.Fatal("expected findEnums to fail for non-contiguous constants")
+	}
+}
+
+func TestFindEnumsRejectsDuplicateNames(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceFile(t, dir, `package example
+
+//enumnames:generate Color
+const (
+	ColorRed Color = iota + 1
+	Red
+)
+
+type Color uint8
+`)
+
+	if _, err := findEnums(dir); err == nil {
+		t.Fatal("expected findEnums to fail for duplicate derived names")
+	}
+}
+
+func TestFindEnumsRejectsOutputFileCollision(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceFile(t, dir, `package example
+
+//enumnames:generate Color
+const (
+	ColorRed Color = iota + 1
+	ColorGreen
+)
+
+type Color uint8
+
+//enumnames:generate COLOR
+const (
+	COLORRed COLOR = iota + 1
+	COLORGreen
+)
+
+type COLOR uint8
+`)
+
+	if _, err := findEnums(dir); err == nil {
+		t.Fatal("expected findEnums to fail when two types generate the same output file name")
+	}
+}
+
+func TestGenerateFile(t *testing.T) {
+	dir := t.TempDir()
+	writeSourceFile(t, dir, validColorSource)
+
+	enums, err := findEnums(dir)
+	if err != nil {
+		t.Fatalf("expected findEnums to succeed, but got error: %v", err)
+	}
+
+	if err := generateFile(dir, enums[0]); err != nil {
+		t.Fatalf("expected generateFile to succeed, but got error: %v", err)
+	}
+
+	generated, err := os.ReadFile(filepath.Join(dir, "color_enumnames.go"))
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	if _, err := parser.ParseFile(fset, "color_enumnames.go", generated, 0); err != nil {
+		t.Fatalf("expected generated file to be valid Go source, but got error: %v", err)
+	}
+
+	for _, wantContains := range []string{
+		"var colorNames = enumnames.NewMap(map[Color]string{",
+		"func (value Color) String() string {",
+		"func (value Color) MarshalJSON() ([]byte, error) {",
+		"func (value *Color) UnmarshalJSON(bytes []byte) error {",
+		"func (value Color) MarshalText() ([]byte, error) {",
+		"func (value *Color) UnmarshalText(text []byte) error {",
+		"func AllColors() []Color {",
+	} {
+		if !strings.Contains(string(generated), wantContains) {
+			t.Fatalf("expected generated file to contain '%s', got:\n%s", wantContains, generated)
+		}
+	}
+}
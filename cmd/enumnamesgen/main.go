@@ -0,0 +1,59 @@
+// Command enumnamesgen generates enumnames.Map-backed boilerplate for integer enum types.
+//
+// It is meant to be invoked through go:generate, e.g.:
+//
+//	//go:generate go run hermannm.dev/enumnames/cmd/enumnamesgen
+//
+// enumnamesgen scans the package containing the directive for constant blocks marked with a
+// magic comment of the form "//enumnames:generate <TypeName>", and for each one emits a file
+// named "<typename>_enumnames.go" containing:
+//   - a package-level enumnames.Map variable for the type
+//   - String, MarshalJSON, UnmarshalJSON, MarshalText and UnmarshalText methods on the type
+//   - an All<TypeName>s function returning all enum values, sorted by their integer value
+//
+// It fails at generate time (printing the error and exiting with a non-zero status) if the marked
+// constants are not a contiguous range starting anywhere, or if two constants would generate the
+// same name.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	dir, err := sourceDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enumnamesgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	enums, err := findEnums(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "enumnamesgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if len(enums) == 0 {
+		fmt.Fprintln(os.Stderr, "enumnamesgen: no //enumnames:generate comments found")
+		os.Exit(1)
+	}
+
+	for _, enum := range enums {
+		if err := generateFile(dir, enum); err != nil {
+			fmt.Fprintf(os.Stderr, "enumnamesgen: %s: %v\n", enum.typeName, err)
+			os.Exit(1)
+		}
+	}
+}
+
+// sourceDir returns the directory of the package being generated for, which is the working
+// directory ("."), since go:generate runs the command in the package's directory. It errors if the
+// GOFILE environment variable that go:generate sets on the invoked command is not set.
+func sourceDir() (string, error) {
+	goFile := os.Getenv("GOFILE")
+	if goFile == "" {
+		return "", fmt.Errorf("GOFILE is not set (enumnamesgen must be run via go:generate)")
+	}
+	return ".", nil
+}
@@ -2,6 +2,7 @@
 package enumnames
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"slices"
@@ -10,10 +11,15 @@ import (
 )
 
 // Map is an immutable mapping of integer enum values to string names.
-// It must be instantiated with NewMap.
+// It must be instantiated with NewMap or NewMapWithOptions.
 type Map[Enum IntegerEnum] struct {
 	names     []string
 	lowestKey Enum
+
+	// reverseIndex maps every name (and alias, if built by NewMapWithOptions) back to its enum
+	// key, so GetKey/ContainsName don't have to fall back to a linear scan over names.
+	reverseIndex         map[string]Enum
+	caseInsensitiveNames bool
 }
 
 type IntegerEnum interface {
@@ -53,9 +59,65 @@ func NewMap[Enum IntegerEnum](enumNames map[Enum]string) Map[Enum] {
 		enumMap.names[index] = name
 	}
 
+	enumMap.buildReverseIndex(nil)
 	return enumMap
 }
 
+// MapOptions configures optional lookup behavior for [NewMapWithOptions].
+type MapOptions[Enum IntegerEnum] struct {
+	// CaseInsensitiveNames makes GetKey, ContainsName and the Unmarshal methods match names
+	// case-insensitively. The canonical name used for marshaling (from GetName and friends) is
+	// unaffected.
+	CaseInsensitiveNames bool
+
+	// Aliases maps an enum key to additional names that should resolve to it in GetKey,
+	// ContainsName and the Unmarshal methods, alongside its canonical name from enumNames. This
+	// is useful for accepting legacy or user-typed spellings of a name (e.g. "first", "First",
+	// "1st") without changing the canonical name used for marshaling.
+	Aliases map[Enum][]string
+}
+
+// NewMapWithOptions is like [NewMap], but additionally applies the given MapOptions, for
+// case-insensitive and/or alias-aware name lookup.
+//
+// Panics under the same conditions as NewMap, and additionally if an alias collides with an
+// existing canonical name or alias (case-insensitively, if CaseInsensitiveNames is set).
+func NewMapWithOptions[Enum IntegerEnum](
+	enumNames map[Enum]string,
+	options MapOptions[Enum],
+) Map[Enum] {
+	enumMap := NewMap(enumNames)
+	enumMap.caseInsensitiveNames = options.CaseInsensitiveNames
+	enumMap.buildReverseIndex(options.Aliases)
+	return enumMap
+}
+
+// buildReverseIndex builds the name-to-key index used by GetKey/ContainsName, from the map's
+// canonical names plus any given aliases. It is called only at construction time (by NewMap, and
+// again by NewMapWithOptions once its options are set), so those lookups are backed by a map
+// instead of a linear scan over names.
+func (enumMap *Map[Enum]) buildReverseIndex(aliases map[Enum][]string) {
+	reverseIndex := make(map[string]Enum, len(enumMap.names))
+	addToIndex := func(name string, key Enum) {
+		lookupName := enumMap.normalizeName(name)
+		if _, exists := reverseIndex[lookupName]; exists {
+			panic(fmt.Sprintf("duplicate enum name or alias '%s' given to enumnames.NewMap", name))
+		}
+		reverseIndex[lookupName] = key
+	}
+
+	for i, name := range enumMap.names {
+		addToIndex(name, enumMap.indexToKey(i))
+	}
+	for key, keyAliases := range aliases {
+		for _, alias := range keyAliases {
+			addToIndex(alias, key)
+		}
+	}
+
+	enumMap.reverseIndex = reverseIndex
+}
+
 // GetName returns the mapped name for the given enum key, or ok=false if no mapping is found.
 func (enumMap Map[Enum]) GetName(key Enum) (name string, ok bool) {
 	if !enumMap.ContainsKey(key) {
@@ -78,14 +140,22 @@ func (enumMap Map[Enum]) GetNameOrFallback(key Enum, fallback string) (name stri
 }
 
 // GetKey returns the enum key mapped to the given name, or ok=false if no mapping is found.
+// If the map was built with NewMapWithOptions, this also matches aliases, and respects
+// CaseInsensitiveNames.
 func (enumMap Map[Enum]) GetKey(name string) (key Enum, ok bool) {
-	for i, candidate := range enumMap.names {
-		if candidate == name {
-			return enumMap.indexToKey(i), true
-		}
-	}
+	key, ok = enumMap.reverseIndex[enumMap.normalizeName(name)]
+	return key, ok
+}
 
-	return 0, false
+// CanonicalName returns the canonical mapped name for the given enum name, or ok=false if no
+// mapping is found. If the given name is an alias (from a map built with NewMapWithOptions), this
+// returns the canonical name it resolves to, rather than the alias itself.
+func (enumMap Map[Enum]) CanonicalName(name string) (canonicalName string, ok bool) {
+	key, ok := enumMap.GetKey(name)
+	if !ok {
+		return "", false
+	}
+	return enumMap.GetName(key)
 }
 
 // ContainsKey checks if the given enum key exists in the map.
@@ -94,14 +164,10 @@ func (enumMap Map[Enum]) ContainsKey(key Enum) bool {
 		key < Enum(len(enumMap.names))+enumMap.lowestKey
 }
 
-// ContainsName checks if any enum key maps to the given name.
+// ContainsName checks if any enum key maps to the given name (or alias, see GetKey).
 func (enumMap Map[Enum]) ContainsName(name string) bool {
-	for _, candidate := range enumMap.names {
-		if candidate == name {
-			return true
-		}
-	}
-	return false
+	_, ok := enumMap.GetKey(name)
+	return ok
 }
 
 // Size returns the number of enum-to-name entries in the map.
@@ -171,12 +237,147 @@ func (enumMap Map[Enum]) UnmarshalFromNameJSON(nameJSON []byte, dest *Enum) erro
 		*dest = key
 		return nil
 	} else {
+		return enumMap.nameNotFoundError(name)
+	}
+}
+
+// MarshalToNameJSONEncoder writes the mapped name for the given enum key to the given encoder, as
+// a JSON string.
+// It errors if the key is not mapped, or if writing to the encoder fails.
+//
+// Use this instead of MarshalToNameJSON when encoding many enum fields in a streaming pipeline, to
+// avoid the intermediate []byte allocation that MarshalToNameJSON's caller would otherwise have to
+// make (and immediately write out) for every value.
+func (enumMap Map[Enum]) MarshalToNameJSONEncoder(enc *json.Encoder, key Enum) error {
+	name, ok := enumMap.GetName(key)
+	if !ok {
+		return fmt.Errorf("invalid value '%d': key not found in enum name map", key)
+	}
+	return enc.Encode(name)
+}
+
+// UnmarshalFromNameJSONDecoder reads the next JSON token from the given decoder, expecting a
+// string, and sets dest to the enum key mapped to that name.
+// It errors if reading the token fails, if the token is not a string, or if the decoded name is
+// not mapped.
+//
+// Use this instead of UnmarshalFromNameJSON when decoding many enum fields in a streaming
+// pipeline: it reads a single token straight off the decoder's stream and matches it against the
+// pre-built name-to-key index, instead of buffering a fresh []byte and going through
+// json.Unmarshal for every value.
+func (enumMap Map[Enum]) UnmarshalFromNameJSONDecoder(dec *json.Decoder, dest *Enum) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	name, ok := token.(string)
+	if !ok {
+		return fmt.Errorf("expected JSON string for enum name, got '%v'", token)
+	}
+
+	if key, ok := enumMap.GetKey(name); ok {
+		*dest = key
+		return nil
+	} else {
+		return enumMap.nameNotFoundError(name)
+	}
+}
+
+// MarshalToNameText marshals the given enum key to its mapped name, as raw text (i.e. not quoted
+// as a JSON string).
+// It errors if the key is not mapped.
+//
+// Use this to implement [encoding.TextMarshaler] on an enum type. This allows the type to be used
+// as a map key in encoding/json (which requires TextMarshaler for non-string keys), and as a
+// struct field or map key in other formats that look for the TextMarshaler interface, such as
+// encoding/xml and most YAML/TOML libraries.
+func (enumMap Map[Enum]) MarshalToNameText(key Enum) ([]byte, error) {
+	if name, ok := enumMap.GetName(key); ok {
+		return []byte(name), nil
+	} else {
+		return nil, fmt.Errorf("invalid value '%d': key not found in enum name map", key)
+	}
+}
+
+// UnmarshalFromNameText sets dest to the enum key mapped to the given name text (given as raw
+// text, i.e. not quoted as a JSON string).
+// It errors if the given name is not mapped.
+//
+// Use this to implement [encoding.TextUnmarshaler] on an enum type.
+func (enumMap Map[Enum]) UnmarshalFromNameText(nameText []byte, dest *Enum) error {
+	name := string(nameText)
+	if key, ok := enumMap.GetKey(name); ok {
+		*dest = key
+		return nil
+	} else {
+		return enumMap.nameNotFoundError(name)
+	}
+}
+
+// MarshalBinaryValue marshals the given enum key to its mapped name, written as a
+// length-prefixed string.
+// It errors if the key is not mapped.
+//
+// Use this to implement [encoding.BinaryMarshaler] on an enum type, which gives plug-in
+// compatibility with binary encoders that look for that interface, such as BSON, CBOR and
+// MessagePack libraries.
+func (enumMap Map[Enum]) MarshalBinaryValue(key Enum) ([]byte, error) {
+	name, ok := enumMap.GetName(key)
+	if !ok {
+		return nil, fmt.Errorf("invalid value '%d': key not found in enum name map", key)
+	}
+
+	data := make([]byte, 4+len(name))
+	binary.LittleEndian.PutUint32(data, uint32(len(name)))
+	copy(data[4:], name)
+	return data, nil
+}
+
+// UnmarshalBinaryValue reads a length-prefixed enum name written by MarshalBinaryValue, and sets
+// dest to the enum key mapped to that name.
+// It errors if the given bytes are malformed, or if the decoded enum name is not mapped.
+//
+// Use this to implement [encoding.BinaryUnmarshaler] on an enum type.
+func (enumMap Map[Enum]) UnmarshalBinaryValue(data []byte, dest *Enum) error {
+	if len(data) < 4 {
 		return fmt.Errorf(
-			"invalid value '%s', expected one of: '%s'",
-			name,
-			strings.Join(enumMap.names, "', '"),
+			"invalid binary enum value: expected at least 4 bytes, got %d",
+			len(data),
 		)
 	}
+
+	length := binary.LittleEndian.Uint32(data)
+	if int(length) != len(data)-4 {
+		return fmt.Errorf(
+			"invalid binary enum value: length prefix %d does not match remaining data of length %d",
+			length,
+			len(data)-4,
+		)
+	}
+
+	name := string(data[4:])
+	if key, ok := enumMap.GetKey(name); ok {
+		*dest = key
+		return nil
+	} else {
+		return enumMap.nameNotFoundError(name)
+	}
+}
+
+func (enumMap Map[Enum]) normalizeName(name string) string {
+	if enumMap.caseInsensitiveNames {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+func (enumMap Map[Enum]) nameNotFoundError(name string) error {
+	return fmt.Errorf(
+		"invalid value '%s', expected one of: '%s'",
+		name,
+		strings.Join(enumMap.names, "', '"),
+	)
 }
 
 func (enumMap Map[Enum]) keyToIndex(key Enum) (index Enum) {
@@ -0,0 +1,93 @@
+package enumnames_test
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+func (test TestEnum) MarshalBinary() ([]byte, error) {
+	return testEnumNames.MarshalBinaryValue(test)
+}
+
+func (test *TestEnum) UnmarshalBinary(data []byte) error {
+	return testEnumNames.UnmarshalBinaryValue(data, test)
+}
+
+func TestMarshalBinaryValue(t *testing.T) {
+	data, err := testEnumNames.MarshalBinaryValue(Test1)
+	if err != nil {
+		t.Fatalf("expected binary marshaling of enum value to succeed, but got error: %v", err)
+	}
+
+	var result TestEnum
+	if err := testEnumNames.UnmarshalBinaryValue(data, &result); err != nil {
+		t.Fatalf("expected binary unmarshaling of enum value to succeed, but got error: %v", err)
+	}
+	if result != Test1 {
+		t.Fatalf("expected '%d', got '%d'", Test1, result)
+	}
+
+	if _, err := testEnumNames.MarshalBinaryValue(TestEnum(100)); err == nil {
+		t.Fatal("expected binary marshaling to fail for invalid enum value")
+	}
+}
+
+func TestUnmarshalBinaryValueErrors(t *testing.T) {
+	var result TestEnum
+
+	if err := testEnumNames.UnmarshalBinaryValue([]byte{1, 2}, &result); err == nil {
+		t.Fatal("expected binary unmarshaling to fail for too-short data")
+	}
+
+	malformed := []byte{100, 0, 0, 0, 'F'}
+	if err := testEnumNames.UnmarshalBinaryValue(malformed, &result); err == nil {
+		t.Fatal("expected binary unmarshaling to fail for mismatched length prefix")
+	}
+
+	unknown, _ := testEnumNames.MarshalBinaryValue(Test1)
+	unknown[4] = 'X' // Corrupt the name so it no longer matches any mapped enum name
+	if err := testEnumNames.UnmarshalBinaryValue(unknown, &result); err == nil {
+		t.Fatal("expected binary unmarshaling to fail for unmapped enum name")
+	}
+}
+
+// TestEnumWithCBOR proves that implementing encoding.BinaryMarshaler/BinaryUnmarshaler through
+// MarshalBinaryValue/UnmarshalBinaryValue gives plug-in compatibility with fxamacker/cbor, which
+// (like BSON and MessagePack libraries) dispatches to those standard interfaces when encoding or
+// decoding a value that implements them.
+func TestEnumWithCBOR(t *testing.T) {
+	data, err := cbor.Marshal(Test2)
+	if err != nil {
+		t.Fatalf("expected CBOR marshaling of enum value to succeed, but got error: %v", err)
+	}
+
+	var result TestEnum
+	if err := cbor.Unmarshal(data, &result); err != nil {
+		t.Fatalf("expected CBOR unmarshaling of enum value to succeed, but got error: %v", err)
+	}
+	if result != Test2 {
+		t.Fatalf("expected '%d', got '%d'", Test2, result)
+	}
+}
+
+type CBORExample struct {
+	EnumField TestEnum
+}
+
+func TestEnumAsCBORStructField(t *testing.T) {
+	example := CBORExample{EnumField: Test1}
+
+	data, err := cbor.Marshal(example)
+	if err != nil {
+		t.Fatalf("expected CBOR marshaling of struct to succeed, but got error: %v", err)
+	}
+
+	var result CBORExample
+	if err := cbor.Unmarshal(data, &result); err != nil {
+		t.Fatalf("expected CBOR unmarshaling of struct to succeed, but got error: %v", err)
+	}
+	if result.EnumField != Test1 {
+		t.Fatalf("expected '%d', got '%d'", Test1, result.EnumField)
+	}
+}
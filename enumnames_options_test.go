@@ -0,0 +1,79 @@
+package enumnames_test
+
+import (
+	"testing"
+
+	"hermannm.dev/enumnames"
+)
+
+var testEnumNamesWithOptions = enumnames.NewMapWithOptions(testEnumMap, enumnames.MapOptions[TestEnum]{
+	CaseInsensitiveNames: true,
+	Aliases: map[TestEnum][]string{
+		Test1: {"1st"},
+	},
+})
+
+func TestCaseInsensitiveGetKey(t *testing.T) {
+	for _, input := range []string{"first", "First", "FIRST", "fIrSt"} {
+		key, ok := testEnumNamesWithOptions.GetKey(input)
+		if !ok {
+			t.Fatalf("expected GetKey('%s') to find a match", input)
+		}
+		if key != Test1 {
+			t.Fatalf("expected '%d', got '%d'", Test1, key)
+		}
+	}
+
+	// The default map should remain case-sensitive.
+	if _, ok := testEnumNames.GetKey("first"); ok {
+		t.Fatal("expected case-sensitive GetKey to not match on differing case")
+	}
+}
+
+func TestAliasGetKey(t *testing.T) {
+	key, ok := testEnumNamesWithOptions.GetKey("1st")
+	if !ok {
+		t.Fatal("expected GetKey('1st') to find a match through its alias")
+	}
+	if key != Test1 {
+		t.Fatalf("expected '%d', got '%d'", Test1, key)
+	}
+}
+
+func TestCanonicalName(t *testing.T) {
+	canonicalName, ok := testEnumNamesWithOptions.CanonicalName("1st")
+	if !ok {
+		t.Fatal("expected CanonicalName('1st') to find a match through its alias")
+	}
+	if canonicalName != "FIRST" {
+		t.Fatalf("expected 'FIRST', got '%s'", canonicalName)
+	}
+
+	if _, ok := testEnumNamesWithOptions.CanonicalName("garbage user input"); ok {
+		t.Fatal("expected CanonicalName to return ok=false for unmapped name")
+	}
+}
+
+func TestContainsNameWithOptions(t *testing.T) {
+	if !testEnumNamesWithOptions.ContainsName("first") {
+		t.Fatal("expected ContainsName to match case-insensitively")
+	}
+	if !testEnumNamesWithOptions.ContainsName("1st") {
+		t.Fatal("expected ContainsName to match an alias")
+	}
+	if testEnumNamesWithOptions.ContainsName("garbage user input") {
+		t.Fatal("expected ContainsName to return false for unmapped name")
+	}
+}
+
+func TestDuplicateAliasPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected NewMapWithOptions to panic on alias colliding with existing name")
+		}
+	}()
+
+	enumnames.NewMapWithOptions(testEnumMap, enumnames.MapOptions[TestEnum]{
+		Aliases: map[TestEnum][]string{Test2: {"FIRST"}},
+	})
+}
@@ -0,0 +1,45 @@
+package enumnames_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalToNameJSONEncoder(t *testing.T) {
+	var buffer bytes.Buffer
+	enc := json.NewEncoder(&buffer)
+
+	if err := testEnumNames.MarshalToNameJSONEncoder(enc, Test1); err != nil {
+		t.Fatalf("expected streaming JSON marshaling to succeed, but got error: %v", err)
+	}
+
+	expected := "\"FIRST\"\n"
+	if buffer.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buffer.String())
+	}
+
+	if err := testEnumNames.MarshalToNameJSONEncoder(enc, TestEnum(100)); err == nil {
+		t.Fatal("expected streaming JSON marshaling to fail for invalid enum value")
+	}
+}
+
+func TestUnmarshalFromNameJSONDecoder(t *testing.T) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(`"FIRST" "garbage user input" 123`)))
+
+	var result TestEnum
+	if err := testEnumNames.UnmarshalFromNameJSONDecoder(dec, &result); err != nil {
+		t.Fatalf("expected streaming JSON unmarshaling to succeed, but got error: %v", err)
+	}
+	if result != Test1 {
+		t.Fatalf("expected '%d', got '%d'", Test1, result)
+	}
+
+	if err := testEnumNames.UnmarshalFromNameJSONDecoder(dec, &result); err == nil {
+		t.Fatal("expected streaming JSON unmarshaling to fail for unmapped enum name")
+	}
+
+	if err := testEnumNames.UnmarshalFromNameJSONDecoder(dec, &result); err == nil {
+		t.Fatal("expected streaming JSON unmarshaling to fail for non-string token")
+	}
+}
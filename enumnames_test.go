@@ -50,9 +50,9 @@ func TestGetNameorFallback(t *testing.T) {
 	}
 }
 
-func TestEnumValueFromName(t *testing.T) {
+func TestGetKey(t *testing.T) {
 	for expectedEnumValue, name := range testEnumMap {
-		enumValue, ok := testEnumNames.EnumValueFromName(name)
+		enumValue, ok := testEnumNames.GetKey(name)
 		if !ok {
 			t.Fatalf("expected '%d', got ok=false", expectedEnumValue)
 		}
@@ -62,22 +62,22 @@ func TestEnumValueFromName(t *testing.T) {
 	}
 
 	invalidName := "garbage user input"
-	_, ok := testEnumNames.EnumValueFromName(invalidName)
+	_, ok := testEnumNames.GetKey(invalidName)
 	if ok {
-		t.Fatal("expected EnumValueFromName to return ok=false with invalid enum name")
+		t.Fatal("expected GetKey to return ok=false with invalid enum name")
 	}
 }
 
-func TestContains(t *testing.T) {
+func TestContainsKey(t *testing.T) {
 	for enumValue := range testEnumMap {
-		if !testEnumNames.Contains(enumValue) {
+		if !testEnumNames.ContainsKey(enumValue) {
 			t.Fatalf("expected enum names to contain entry for enum value '%d'", enumValue)
 		}
 	}
 
 	invalidEnumValue := TestEnum(100)
-	if testEnumNames.Contains(invalidEnumValue) {
-		t.Fatal("expected Contains to return false for invalid enum value")
+	if testEnumNames.ContainsKey(invalidEnumValue) {
+		t.Fatal("expected ContainsKey to return false for invalid enum value")
 	}
 }
 
@@ -88,15 +88,15 @@ func TestSize(t *testing.T) {
 	}
 }
 
-func TestEnumValues(t *testing.T) {
-	enumValues := testEnumNames.EnumValues()
+func TestKeys(t *testing.T) {
+	keys := testEnumNames.Keys()
 
-	if len(enumValues) != 3 {
-		t.Fatalf("expected enum values with length 3, got %+v", enumValues)
+	if len(keys) != 3 {
+		t.Fatalf("expected enum keys with length 3, got %+v", keys)
 	}
 
-	if enumValues[0] != Test1 || enumValues[1] != Test2 || enumValues[2] != Test3 {
-		t.Fatalf("expected [Test1, Test2, Test3], got %+v", enumValues)
+	if keys[0] != Test1 || keys[1] != Test2 || keys[2] != Test3 {
+		t.Fatalf("expected [Test1, Test2, Test3], got %+v", keys)
 	}
 }
 
@@ -0,0 +1,99 @@
+package enumnames_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func (test TestEnum) MarshalText() ([]byte, error) {
+	return testEnumNames.MarshalToNameText(test)
+}
+
+func (test *TestEnum) UnmarshalText(text []byte) error {
+	return testEnumNames.UnmarshalFromNameText(text, test)
+}
+
+func TestMarshalToNameText(t *testing.T) {
+	bytes, err := testEnumNames.MarshalToNameText(Test1)
+	if err != nil {
+		t.Fatalf("expected text marshaling of enum value to succeed, but got error: %v", err)
+	}
+
+	expected := "FIRST"
+	if string(bytes) != expected {
+		t.Fatalf("expected '%s', got '%s'", expected, string(bytes))
+	}
+
+	if _, err := testEnumNames.MarshalToNameText(TestEnum(100)); err == nil {
+		t.Fatal("expected text marshaling to fail for invalid enum value")
+	}
+}
+
+func TestUnmarshalFromNameText(t *testing.T) {
+	var result TestEnum
+	if err := result.UnmarshalText([]byte("FIRST")); err != nil {
+		t.Fatalf("expected text unmarshaling of enum value to succeed, but got error: %v", err)
+	}
+	if result != Test1 {
+		t.Fatalf("expected '%d', got '%d'", Test1, result)
+	}
+
+	var result2 TestEnum
+	if err := result2.UnmarshalText([]byte("garbage user input")); err == nil {
+		t.Fatal("expected text unmarshaling to fail for invalid enum name")
+	}
+}
+
+// TestEnumAsJSONMapKey verifies that implementing encoding.TextMarshaler/TextUnmarshaler through
+// MarshalToNameText/UnmarshalFromNameText allows enum values to be used as map keys in
+// encoding/json, which requires TextMarshaler for non-string map key types.
+func TestEnumAsJSONMapKey(t *testing.T) {
+	input := map[TestEnum]int{Test1: 1, Test2: 2}
+
+	bytes, err := json.Marshal(input)
+	if err != nil {
+		t.Fatalf("expected JSON marshaling of enum-keyed map to succeed, but got error: %v", err)
+	}
+
+	expected := `{"FIRST":1,"SECOND":2}`
+	if string(bytes) != expected {
+		t.Fatalf("expected '%s', got '%s'", expected, string(bytes))
+	}
+
+	var result map[TestEnum]int
+	if err := json.Unmarshal(bytes, &result); err != nil {
+		t.Fatalf("expected JSON unmarshaling of enum-keyed map to succeed, but got error: %v", err)
+	}
+	if result[Test1] != 1 || result[Test2] != 2 {
+		t.Fatalf("expected %+v, got %+v", input, result)
+	}
+}
+
+type XMLExample struct {
+	EnumField TestEnum `xml:"enumField"`
+}
+
+// TestEnumAsXMLField verifies that implementing encoding.TextMarshaler/TextUnmarshaler gives
+// plug-in compatibility with encoding/xml, which looks for the same interfaces on struct fields.
+func TestEnumAsXMLField(t *testing.T) {
+	example := XMLExample{EnumField: Test2}
+
+	bytes, err := xml.Marshal(example)
+	if err != nil {
+		t.Fatalf("expected XML marshaling of enum field to succeed, but got error: %v", err)
+	}
+
+	expected := `<XMLExample><enumField>SECOND</enumField></XMLExample>`
+	if string(bytes) != expected {
+		t.Fatalf("expected '%s', got '%s'", expected, string(bytes))
+	}
+
+	var result XMLExample
+	if err := xml.Unmarshal(bytes, &result); err != nil {
+		t.Fatalf("expected XML unmarshaling of enum field to succeed, but got error: %v", err)
+	}
+	if result.EnumField != Test2 {
+		t.Fatalf("expected '%d', got '%d'", Test2, result.EnumField)
+	}
+}